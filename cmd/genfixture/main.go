@@ -0,0 +1,44 @@
+// Command genfixture writes synthetic, deeply nested Go source files for
+// stress-testing MetricMancer's Go analyzers, alongside a sidecar
+// .expected.json with the metrics the generated file should score.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/genfixture"
+)
+
+func main() {
+	kind := flag.String("kind", "if", "construct to nest: if, for, switch, mixed")
+	depth := flag.Int("depth", 3, "number of nested levels")
+	out := flag.String("out", "fixture", "output file base name (writes <out>.go and <out>.expected.json)")
+	flag.Parse()
+
+	src, expected := genfixture.Generate(genfixture.Options{
+		Kind:  genfixture.Kind(*kind),
+		Depth: *depth,
+	})
+
+	if err := os.WriteFile(*out+".go", []byte(src), 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	f, err := os.Create(*out + ".expected.json")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(expected); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,104 @@
+// Command metricmancer scans a Go source tree and reports code complexity
+// metrics.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/cognitive"
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/maxnesting"
+	"github.com/CmdrPrompt/MetricMancer/internal/report"
+)
+
+// subcommands dispatches on os.Args[1] before falling back to the default
+// cognitive complexity scan, in the manner of `go <subcommand>`.
+var subcommands = map[string]func(args []string) error{
+	"nestif": runNestif,
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		if cmd, ok := subcommands[os.Args[1]]; ok {
+			if err := cmd(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	cognitiveThreshold := flag.Int("cognitive-threshold", 15, "fail if any function's cognitive complexity exceeds this value")
+	maxNesting := flag.Int("max-nesting", 4, "fail if any function's block nesting depth exceeds this value")
+	skipTests := flag.Bool("skip-test-files", true, "don't gate cognitive-threshold/max-nesting on functions declared in _test.go files")
+	skipBenchmarks := flag.Bool("skip-benchmarks", true, "don't gate cognitive-threshold/max-nesting on Benchmark* functions")
+	flag.Parse()
+
+	root := "."
+	if flag.NArg() > 0 {
+		root = flag.Arg(0)
+	}
+
+	overThreshold := false
+	fset := token.NewFileSet()
+	analyzer := cognitive.New(fset)
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		isTestFile := strings.HasSuffix(path, "_test.go")
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+
+		scores := analyzer.AnalyzeFile(file)
+		gateScores := scores
+		if *skipTests && isTestFile {
+			gateScores = nil
+		} else if *skipBenchmarks {
+			gateScores = nil
+			for _, s := range scores {
+				if !strings.HasPrefix(s.Name, "Benchmark") {
+					gateScores = append(gateScores, s)
+				}
+			}
+		}
+		if report.CognitiveReport(os.Stdout, path, scores, gateScores, *cognitiveThreshold) {
+			overThreshold = true
+		}
+
+		depths := maxnesting.AnalyzeFile(fset, file)
+		gateDepths := depths
+		if *skipTests && isTestFile {
+			gateDepths = nil
+		} else if *skipBenchmarks {
+			gateDepths = nil
+			for _, d := range depths {
+				if !d.IsBenchmark {
+					gateDepths = append(gateDepths, d)
+				}
+			}
+		}
+		if report.MaxNestingReport(os.Stdout, path, depths, gateDepths, *maxNesting) {
+			overThreshold = true
+		}
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if overThreshold {
+		os.Exit(1)
+	}
+}
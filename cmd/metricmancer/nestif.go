@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/nestif"
+	"github.com/CmdrPrompt/MetricMancer/internal/scanner"
+)
+
+// runNestif implements the `metricmancer nestif` subcommand: a ranking of
+// the most deeply nested if statements under the given root.
+func runNestif(args []string) error {
+	fs := flag.NewFlagSet("nestif", flag.ExitOnError)
+	min := fs.Int("min", 1, "minimum complexity to display")
+	top := fs.Int("top", 0, "only show the N worst findings (0 = all)")
+	excludeDirs := fs.String("exclude-dirs", "", "comma-separated regexes of directory names to skip")
+	jsonOut := fs.Bool("json", false, "emit findings as JSON instead of text")
+	fs.Parse(args)
+
+	root := "."
+	if fs.NArg() > 0 {
+		root = fs.Arg(0)
+	}
+
+	excludes, err := scanner.CompileExcludeDirs(*excludeDirs)
+	if err != nil {
+		return fmt.Errorf("--exclude-dirs: %w", err)
+	}
+	files, err := scanner.GoFiles(root, excludes)
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	var all []nestif.Finding
+	for _, path := range files {
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+		all = append(all, nestif.AnalyzeFile(fset, path, file)...)
+	}
+
+	var findings []nestif.Finding
+	for _, f := range all {
+		if f.Complexity >= *min {
+			findings = append(findings, f)
+		}
+	}
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Complexity > findings[j].Complexity })
+	if *top > 0 && len(findings) > *top {
+		findings = findings[:*top]
+	}
+
+	if *jsonOut {
+		return json.NewEncoder(os.Stdout).Encode(findings)
+	}
+	for _, f := range findings {
+		fmt.Printf("%s:%d:%d: complexity %d: %s\n", f.File, f.Line, f.Col, f.Complexity, f.Message)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Package boolexpr scores the boolean operator sequences inside a single
+// condition expression, per Campbell's cognitive complexity rules: a
+// contiguous run of the same logical operator (&& or ||) costs +1, and each
+// time the operator flips within the same expression costs +1 more.
+// Entering a negated (!) sub-expression counts as a flip between the outer
+// and inner operator, since it changes how the reader has to parse the rest
+// of the condition.
+package boolexpr
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// Increment is a single +1 charge from a logical operator starting a new
+// run, or from crossing into a negated sub-expression.
+type Increment struct {
+	Line int
+	Op   string // "&&", "||", or "!" for a negation boundary
+}
+
+// Score walks expr (typically an *ast.IfStmt or *ast.ForStmt condition) and
+// returns the total boolean sequence-break score plus a breakdown of the
+// individual charges, in source order.
+func Score(fset *token.FileSet, expr ast.Expr) (int, []Increment) {
+	if expr == nil {
+		return 0, nil
+	}
+	s := &scorer{fset: fset}
+	s.walk(expr)
+	return s.score, s.breakdown
+}
+
+type scorer struct {
+	fset      *token.FileSet
+	lastOp    token.Token // token.ILLEGAL (zero value) means "no run yet"
+	score     int
+	breakdown []Increment
+}
+
+func (s *scorer) walk(expr ast.Expr) {
+	switch e := expr.(type) {
+	case nil:
+		return
+	case *ast.ParenExpr:
+		s.walk(e.X)
+	case *ast.UnaryExpr:
+		if e.Op == token.NOT && containsLogicalOp(e.X) {
+			s.chargeNegation(e.OpPos)
+		}
+		s.walk(e.X)
+	case *ast.BinaryExpr:
+		if e.Op == token.LAND || e.Op == token.LOR {
+			s.walk(e.X)
+			s.chargeOp(e.Op, e.OpPos)
+			s.walk(e.Y)
+		} else {
+			s.walk(e.X)
+			s.walk(e.Y)
+		}
+	}
+}
+
+// chargeOp charges +1 the first time this operator is seen, and again every
+// time it differs from the operator of the previous run.
+func (s *scorer) chargeOp(op token.Token, pos token.Pos) {
+	if op == s.lastOp {
+		return
+	}
+	s.lastOp = op
+	s.score++
+	s.breakdown = append(s.breakdown, Increment{Line: s.fset.Position(pos).Line, Op: opString(op)})
+}
+
+// chargeNegation always charges +1 and resets the run so the next operator
+// seen is treated as starting a fresh run, matching the rule that entering
+// a negated sub-expression is itself a flip.
+func (s *scorer) chargeNegation(pos token.Pos) {
+	s.lastOp = token.ILLEGAL
+	s.score++
+	s.breakdown = append(s.breakdown, Increment{Line: s.fset.Position(pos).Line, Op: "!"})
+}
+
+// containsLogicalOp reports whether expr contains a && or || anywhere in
+// its tree, i.e. whether negating it actually interrupts a boolean
+// sequence rather than just a bare identifier or comparison.
+func containsLogicalOp(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if be, ok := n.(*ast.BinaryExpr); ok && (be.Op == token.LAND || be.Op == token.LOR) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func opString(op token.Token) string {
+	if op == token.LAND {
+		return "&&"
+	}
+	return "||"
+}
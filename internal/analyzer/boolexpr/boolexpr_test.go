@@ -0,0 +1,37 @@
+package boolexpr
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name string
+		cond string
+		want int
+	}{
+		{"single &&", "a && b", 1},
+		{"single ||", "a || b", 1},
+		{"campbell example", "a && b && c || d || e && f", 3},
+		{"negated compound", "!(a && b)", 2}, // entering the negation, then the && run inside it
+		{"negated identifier", "!a", 0},
+		{"no boolean ops", "a == b", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			expr, err := parser.ParseExpr(tt.cond)
+			if err != nil {
+				t.Fatalf("ParseExpr(%q): %v", tt.cond, err)
+			}
+
+			got, _ := Score(fset, expr)
+			if got != tt.want {
+				t.Errorf("Score(%q) = %d, want %d", tt.cond, got, tt.want)
+			}
+		})
+	}
+}
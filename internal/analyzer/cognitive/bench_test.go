@@ -0,0 +1,34 @@
+package cognitive
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/genfixture"
+)
+
+// BenchmarkAnalyzeFile_DeepNesting measures analyzer throughput on the kind
+// of deeply nested function real-world corpora rarely contain, so
+// regressions in the AST walker show up here rather than in the field.
+func BenchmarkAnalyzeFile_DeepNesting(b *testing.B) {
+	for _, depth := range []int{10, 50, 200} {
+		depth := depth
+		b.Run(fmt.Sprintf("depth%d", depth), func(b *testing.B) {
+			src, _ := genfixture.Generate(genfixture.Options{Kind: genfixture.KindIf, Depth: depth})
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "generated.go", src, 0)
+			if err != nil {
+				b.Fatalf("ParseFile: %v", err)
+			}
+
+			analyzer := New(fset)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				analyzer.AnalyzeFile(file)
+			}
+		})
+	}
+}
+
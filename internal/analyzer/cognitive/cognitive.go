@@ -0,0 +1,268 @@
+// Package cognitive computes per-function cognitive complexity for Go source
+// files, following the scoring rules from G. Ann Campbell's "Cognitive
+// Complexity" white paper: a flat +1 for each control-flow structure that
+// breaks the linear reading of a method, plus a nesting penalty equal to the
+// current nesting depth for every structure that carries a body.
+package cognitive
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/boolexpr"
+)
+
+// Increment is a single scoring event produced while walking a function
+// body. Kind identifies the AST construct responsible (e.g. "if", "for",
+// "switch"), Base is the flat +1 every construct contributes, Nesting is the
+// extra penalty for the current nesting depth, and Total is Base+Nesting.
+type Increment struct {
+	Kind    string
+	Line    int
+	Base    int
+	Nesting int
+	Total   int
+}
+
+// FunctionScore is the cognitive complexity result for a single function or
+// method declaration.
+type FunctionScore struct {
+	Name      string
+	Line      int
+	Score     int
+	Breakdown []Increment
+}
+
+// Analyzer computes cognitive complexity scores for the functions declared
+// in a parsed Go file.
+type Analyzer struct {
+	Fset *token.FileSet
+}
+
+// New returns an Analyzer that resolves positions using fset.
+func New(fset *token.FileSet) *Analyzer {
+	return &Analyzer{Fset: fset}
+}
+
+// AnalyzeFile returns a FunctionScore for every top-level function and
+// method declaration in file that has a body.
+func (a *Analyzer) AnalyzeFile(file *ast.File) []FunctionScore {
+	var scores []FunctionScore
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		w := &walker{fset: a.Fset}
+		w.walkBlock(fn.Body, 0)
+		scores = append(scores, FunctionScore{
+			Name:      fn.Name.Name,
+			Line:      a.Fset.Position(fn.Pos()).Line,
+			Score:     w.score,
+			Breakdown: w.breakdown,
+		})
+	}
+	return scores
+}
+
+// walker accumulates the score and breakdown for a single function body.
+type walker struct {
+	fset      *token.FileSet
+	score     int
+	breakdown []Increment
+}
+
+// add records a scoring event at the given nesting level.
+func (w *walker) add(kind string, pos token.Pos, nesting int) {
+	total := 1 + nesting
+	w.score += total
+	w.breakdown = append(w.breakdown, Increment{
+		Kind:    kind,
+		Line:    w.fset.Position(pos).Line,
+		Base:    1,
+		Nesting: nesting,
+		Total:   total,
+	})
+}
+
+// merge folds the score and breakdown of a nested walker (used for function
+// literals, which reset nesting but still contribute to the enclosing
+// function's total) into w.
+func (w *walker) merge(sub *walker) {
+	w.score += sub.score
+	w.breakdown = append(w.breakdown, sub.breakdown...)
+}
+
+func (w *walker) walkBlock(block *ast.BlockStmt, nesting int) {
+	if block == nil {
+		return
+	}
+	for _, stmt := range block.List {
+		w.walkStmt(stmt, nesting)
+	}
+}
+
+func (w *walker) walkStmt(stmt ast.Stmt, nesting int) {
+	switch s := stmt.(type) {
+	case *ast.LabeledStmt:
+		w.walkStmt(s.Stmt, nesting)
+	case *ast.IfStmt:
+		w.scanExprs(s.Init, s.Cond)
+		w.scoreBoolCond(s.Cond)
+		w.walkIf(s, nesting)
+	case *ast.ForStmt:
+		w.scanExprs(s.Init, s.Cond, s.Post)
+		w.scoreBoolCond(s.Cond)
+		w.add("for", s.For, nesting)
+		w.walkBlock(s.Body, nesting+1)
+	case *ast.RangeStmt:
+		w.scanExprs(s.X)
+		w.add("range", s.For, nesting)
+		w.walkBlock(s.Body, nesting+1)
+	case *ast.SwitchStmt:
+		w.scanExprs(s.Init, s.Tag)
+		w.add("switch", s.Switch, nesting)
+		w.walkCaseClauses(s.Body, nesting)
+	case *ast.TypeSwitchStmt:
+		w.scanExprs(s.Init)
+		w.add("switch", s.Switch, nesting)
+		w.walkCaseClauses(s.Body, nesting)
+	case *ast.SelectStmt:
+		w.add("select", s.Select, nesting)
+		w.walkCommClauses(s.Body, nesting)
+	case *ast.BranchStmt:
+		if s.Label != nil {
+			w.add(branchKind(s.Tok), s.Pos(), 0)
+		}
+	case *ast.BlockStmt:
+		w.walkBlock(s, nesting)
+	default:
+		w.scanExprs(stmt)
+	}
+}
+
+// walkIf handles the if/else-if/else chain as a unit: an else-if is scored
+// like a fresh if at the same nesting level as the statement it replaces,
+// while a trailing else block pays the nesting penalty of the branch it
+// introduces.
+func (w *walker) walkIf(s *ast.IfStmt, nesting int) {
+	w.add("if", s.If, nesting)
+	w.walkBlock(s.Body, nesting+1)
+	switch e := s.Else.(type) {
+	case *ast.IfStmt:
+		w.scanExprs(e.Init, e.Cond)
+		w.scoreBoolCond(e.Cond)
+		w.add("else-if", e.If, nesting)
+		w.walkBlock(e.Body, nesting+1)
+		w.walkElseTail(e.Else, nesting)
+	case *ast.BlockStmt:
+		w.add("else", e.Pos(), nesting)
+		w.walkBlock(e, nesting+1)
+	}
+}
+
+func (w *walker) walkElseTail(elseStmt ast.Stmt, nesting int) {
+	switch e := elseStmt.(type) {
+	case *ast.IfStmt:
+		w.scanExprs(e.Init, e.Cond)
+		w.scoreBoolCond(e.Cond)
+		w.add("else-if", e.If, nesting)
+		w.walkBlock(e.Body, nesting+1)
+		w.walkElseTail(e.Else, nesting)
+	case *ast.BlockStmt:
+		w.add("else", e.Pos(), nesting)
+		w.walkBlock(e, nesting+1)
+	}
+}
+
+func (w *walker) walkCaseClauses(body *ast.BlockStmt, nesting int) {
+	if body == nil {
+		return
+	}
+	for _, stmt := range body.List {
+		cc, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		for _, expr := range cc.List {
+			w.scanExprs(expr)
+		}
+		for _, bodyStmt := range cc.Body {
+			w.walkStmt(bodyStmt, nesting+1)
+		}
+	}
+}
+
+func (w *walker) walkCommClauses(body *ast.BlockStmt, nesting int) {
+	if body == nil {
+		return
+	}
+	for _, stmt := range body.List {
+		cc, ok := stmt.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+		for _, bodyStmt := range cc.Body {
+			w.walkStmt(bodyStmt, nesting+1)
+		}
+	}
+}
+
+// scanExprs looks inside expressions (conditions, call arguments, assigned
+// values, ...) for function literals and recover() calls, both of which can
+// appear without a surrounding statement the main switch would otherwise
+// visit. Function literals reset nesting to 0 but their score is folded into
+// the enclosing function's total.
+func (w *walker) scanExprs(nodes ...ast.Node) {
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		ast.Inspect(n, func(node ast.Node) bool {
+			switch x := node.(type) {
+			case *ast.FuncLit:
+				sub := &walker{fset: w.fset}
+				sub.walkBlock(x.Body, 0)
+				w.merge(sub)
+				return false
+			case *ast.CallExpr:
+				if id, ok := x.Fun.(*ast.Ident); ok && id.Name == "recover" {
+					w.add("recover", x.Pos(), 0)
+				}
+			}
+			return true
+		})
+	}
+}
+
+// scoreBoolCond adds the boolean operator sequence-break score for a
+// condition expression (see package boolexpr) to the running total.
+func (w *walker) scoreBoolCond(cond ast.Expr) {
+	score, incs := boolexpr.Score(w.fset, cond)
+	if score == 0 {
+		return
+	}
+	w.score += score
+	for _, inc := range incs {
+		w.breakdown = append(w.breakdown, Increment{
+			Kind:    "bool-op:" + inc.Op,
+			Line:    inc.Line,
+			Base:    1,
+			Nesting: 0,
+			Total:   1,
+		})
+	}
+}
+
+func branchKind(tok token.Token) string {
+	switch tok {
+	case token.GOTO:
+		return "goto-label"
+	case token.BREAK:
+		return "break-label"
+	case token.CONTINUE:
+		return "continue-label"
+	default:
+		return "branch-label"
+	}
+}
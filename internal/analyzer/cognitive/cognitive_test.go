@@ -0,0 +1,175 @@
+package cognitive
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestAnalyzeFile_NestedIfsFixture(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "../../../tests/fixtures/go/nested_ifs.go", nil, 0)
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+
+	scores := New(fset).AnalyzeFile(file)
+	if len(scores) != 1 {
+		t.Fatalf("got %d function scores, want 1", len(scores))
+	}
+
+	got := scores[0]
+	if got.Name != "nestedIfs" {
+		t.Errorf("Name = %q, want %q", got.Name, "nestedIfs")
+	}
+	if got.Score != 6 {
+		t.Errorf("Score = %d, want 6 (breakdown: %+v)", got.Score, got.Breakdown)
+	}
+
+	wantTotals := []int{1, 2, 3}
+	if len(got.Breakdown) != len(wantTotals) {
+		t.Fatalf("len(Breakdown) = %d, want %d", len(got.Breakdown), len(wantTotals))
+	}
+	for i, want := range wantTotals {
+		if got.Breakdown[i].Total != want {
+			t.Errorf("Breakdown[%d].Total = %d, want %d", i, got.Breakdown[i].Total, want)
+		}
+	}
+}
+
+func TestAnalyzeFile(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want int
+	}{
+		{
+			name: "else-if chain stays flat",
+			src: `package p
+func f(x int) int {
+	if x == 1 {
+		return 1
+	} else if x == 2 {
+		return 2
+	} else if x == 3 {
+		return 3
+	} else {
+		return 4
+	}
+}`,
+			// if (+1), else-if (+1), else-if (+1), else (+1): none nest inside
+			// one another, so each pays only the flat base charge.
+			want: 4,
+		},
+		{
+			name: "for and range are independent structures",
+			src: `package p
+func f(xs []int) int {
+	total := 0
+	for i := 0; i < len(xs); i++ { // +1
+		for range xs { // +2 (1 base + 1 nesting)
+			total++
+		}
+	}
+	return total
+}`,
+			want: 3,
+		},
+		{
+			name: "multi-case switch scores once for the switch, not per case",
+			src: `package p
+func f(x int) int {
+	switch x {
+	case 1:
+		return 1
+	case 2:
+		return 2
+	case 3:
+		return 3
+	case 4:
+		return 4
+	case 5:
+		return 5
+	}
+	return 0
+}`,
+			want: 1,
+		},
+		{
+			name: "select scores once across its comm clauses",
+			src: `package p
+func f(a, b chan int) int {
+	select {
+	case v := <-a:
+		return v
+	case v := <-b:
+		return v
+	default:
+		return 0
+	}
+}`,
+			want: 1,
+		},
+		{
+			name: "break to a label charges flat, break without a label is free",
+			src: `package p
+func f(xs [][]int) int {
+Outer:
+	for _, row := range xs { // +1
+		for _, v := range row { // +2
+			if v < 0 { // +3
+				break Outer // +1 flat, labeled branch
+			}
+			if v == 0 { // +3
+				break // free, no label
+			}
+		}
+	}
+	return 0
+}`,
+			want: 10,
+		},
+		{
+			name: "nested func literal contributes its own score",
+			src: `package p
+func f(xs []int) func() bool {
+	return func() bool { // func literal body scored on its own, nesting reset to 0
+		if len(xs) > 0 { // +1
+			return true
+		}
+		return false
+	}
+}`,
+			want: 1,
+		},
+		{
+			name: "recover inside a deferred func literal charges flat",
+			src: `package p
+func f() {
+	defer func() { // func literal
+		if recover() != nil { // +1 (if) +1 (recover) = +2
+		}
+	}()
+}`,
+			want: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "test.go", tt.src, 0)
+			if err != nil {
+				t.Fatalf("ParseFile: %v", err)
+			}
+
+			scores := New(fset).AnalyzeFile(file)
+			if len(scores) != 1 {
+				t.Fatalf("got %d function scores, want 1", len(scores))
+			}
+			if got := scores[0].Score; got != tt.want {
+				t.Errorf("Score = %d, want %d (breakdown: %+v)", got, tt.want, scores[0].Breakdown)
+			}
+		})
+	}
+}
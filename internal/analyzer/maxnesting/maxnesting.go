@@ -0,0 +1,99 @@
+// Package maxnesting computes, per function, the maximum block nesting
+// depth reached by its control-flow structures. It is a much cheaper signal
+// than cognitive complexity, but still a useful, easily enforceable
+// refactoring trigger.
+package maxnesting
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// FunctionDepth is the maximum nesting depth reached inside a single
+// function or method declaration.
+type FunctionDepth struct {
+	Name        string
+	Line        int
+	MaxDepth    int
+	IsTest      bool
+	IsBenchmark bool
+}
+
+// AnalyzeFile returns a FunctionDepth for every top-level function and
+// method declaration in file that has a body.
+func AnalyzeFile(fset *token.FileSet, file *ast.File) []FunctionDepth {
+	var results []FunctionDepth
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		results = append(results, FunctionDepth{
+			Name:        fn.Name.Name,
+			Line:        fset.Position(fn.Pos()).Line,
+			MaxDepth:    maxDepth(fn.Body, 0),
+			IsTest:      strings.HasPrefix(fn.Name.Name, "Test"),
+			IsBenchmark: strings.HasPrefix(fn.Name.Name, "Benchmark"),
+		})
+	}
+	return results
+}
+
+func maxDepth(block *ast.BlockStmt, depth int) int {
+	if block == nil {
+		return depth
+	}
+	max := depth
+	for _, stmt := range block.List {
+		if d := stmtDepth(stmt, depth); d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+func stmtDepth(stmt ast.Stmt, depth int) int {
+	switch s := stmt.(type) {
+	case *ast.BlockStmt:
+		return maxDepth(s, depth+1)
+	case *ast.IfStmt:
+		max := maxDepth(s.Body, depth+1)
+		if s.Else != nil {
+			if d := stmtDepth(s.Else, depth); d > max {
+				max = d
+			}
+		}
+		return max
+	case *ast.ForStmt:
+		return maxDepth(s.Body, depth+1)
+	case *ast.RangeStmt:
+		return maxDepth(s.Body, depth+1)
+	case *ast.SwitchStmt:
+		return maxDepth(s.Body, depth+1)
+	case *ast.TypeSwitchStmt:
+		return maxDepth(s.Body, depth+1)
+	case *ast.SelectStmt:
+		return maxDepth(s.Body, depth+1)
+	case *ast.CaseClause:
+		max := depth
+		for _, bodyStmt := range s.Body {
+			if d := stmtDepth(bodyStmt, depth+1); d > max {
+				max = d
+			}
+		}
+		return max
+	case *ast.CommClause:
+		max := depth
+		for _, bodyStmt := range s.Body {
+			if d := stmtDepth(bodyStmt, depth+1); d > max {
+				max = d
+			}
+		}
+		return max
+	case *ast.LabeledStmt:
+		return stmtDepth(s.Stmt, depth)
+	default:
+		return depth
+	}
+}
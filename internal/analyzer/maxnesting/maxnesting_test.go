@@ -0,0 +1,149 @@
+package maxnesting
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestAnalyzeFile_NestedIfsFixture(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "../../../tests/fixtures/go/nested_ifs.go", nil, 0)
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+
+	results := AnalyzeFile(fset, file)
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	if results[0].MaxDepth != 3 {
+		t.Errorf("MaxDepth = %d, want 3", results[0].MaxDepth)
+	}
+}
+
+func TestAnalyzeFile(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want int
+	}{
+		{
+			name: "if nested inside a for loop",
+			src: `package p
+func f(xs []int) int {
+	for _, x := range xs {
+		if x < 0 {
+			return x
+		}
+	}
+	return 0
+}`,
+			want: 2,
+		},
+		{
+			name: "a single switch's case body is two layers deep",
+			src: `package p
+func f(x int) int {
+	switch x {
+	case 1:
+		return 1
+	}
+	return 0
+}`,
+			want: 2,
+		},
+		{
+			name: "select behaves like switch",
+			src: `package p
+func f(a chan int) int {
+	select {
+	case v := <-a:
+		return v
+	}
+	return 0
+}`,
+			want: 2,
+		},
+		{
+			name: "nested switches compound",
+			src: `package p
+func f(x, y int) int {
+	switch x {
+	case 1:
+		switch y {
+		case 1:
+			return 1
+		}
+	}
+	return 0
+}`,
+			want: 4,
+		},
+		{
+			name: "a deep else branch is tracked independently of the if branch",
+			src: `package p
+func f(x int) int {
+	if x == 1 {
+		return 1
+	} else {
+		if x == 2 {
+			if x == 3 {
+				return 3
+			}
+		}
+	}
+	return 0
+}`,
+			want: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "test.go", tt.src, 0)
+			if err != nil {
+				t.Fatalf("ParseFile: %v", err)
+			}
+
+			results := AnalyzeFile(fset, file)
+			if len(results) != 1 {
+				t.Fatalf("got %d results, want 1", len(results))
+			}
+			if got := results[0].MaxDepth; got != tt.want {
+				t.Errorf("MaxDepth = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeFile_TestAndBenchmarkFlags(t *testing.T) {
+	src := `package p
+import "testing"
+func Helper() {}
+func TestSomething(t *testing.T) {}
+func BenchmarkSomething(b *testing.B) {}`
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", src, 0)
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+
+	results := AnalyzeFile(fset, file)
+	got := map[string]FunctionDepth{}
+	for _, r := range results {
+		got[r.Name] = r
+	}
+
+	if got["Helper"].IsTest || got["Helper"].IsBenchmark {
+		t.Errorf("Helper: IsTest=%v IsBenchmark=%v, want both false", got["Helper"].IsTest, got["Helper"].IsBenchmark)
+	}
+	if !got["TestSomething"].IsTest {
+		t.Error("TestSomething: IsTest = false, want true")
+	}
+	if !got["BenchmarkSomething"].IsBenchmark {
+		t.Error("BenchmarkSomething: IsBenchmark = false, want true")
+	}
+}
@@ -0,0 +1,139 @@
+// Package nestif reports the most deeply nested if statements in a Go
+// source tree, mirroring the focused nestif linter: unlike the per-function
+// cognitive complexity metric, the unit here is the individual if statement,
+// which is what gets refactored.
+package nestif
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/boolexpr"
+)
+
+// Finding is a single *ast.IfStmt whose localized nesting score is worth
+// reporting.
+type Finding struct {
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Col        int    `json:"col"`
+	Complexity int    `json:"complexity"`
+	Message    string `json:"message"`
+}
+
+// AnalyzeFile returns a Finding for every if statement in the bodies of
+// file's function declarations, scored using the Campbell nesting rule: each
+// if (an else-if included) scores 1 plus its current depth, plus the boolean
+// sequence-break score of its condition. An else block is not scored itself;
+// only the ifs inside it are. If statements inside function literals are not
+// visited.
+func AnalyzeFile(fset *token.FileSet, filename string, file *ast.File) []Finding {
+	var findings []Finding
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		w := &walker{fset: fset, filename: filename}
+		w.walkBlock(fn.Body, 0)
+		findings = append(findings, w.findings...)
+	}
+	return findings
+}
+
+type walker struct {
+	fset     *token.FileSet
+	filename string
+	findings []Finding
+}
+
+func (w *walker) walkBlock(block *ast.BlockStmt, depth int) {
+	if block == nil {
+		return
+	}
+	for _, stmt := range block.List {
+		w.walkStmt(stmt, depth)
+	}
+}
+
+func (w *walker) walkStmt(stmt ast.Stmt, depth int) {
+	switch s := stmt.(type) {
+	case *ast.IfStmt:
+		w.walkIf(s, depth)
+	case *ast.ForStmt:
+		w.walkBlock(s.Body, depth)
+	case *ast.RangeStmt:
+		w.walkBlock(s.Body, depth)
+	case *ast.SwitchStmt:
+		w.walkCaseClauses(s.Body, depth)
+	case *ast.TypeSwitchStmt:
+		w.walkCaseClauses(s.Body, depth)
+	case *ast.SelectStmt:
+		w.walkCommClauses(s.Body, depth)
+	case *ast.BlockStmt:
+		w.walkBlock(s, depth)
+	case *ast.LabeledStmt:
+		w.walkStmt(s.Stmt, depth)
+	}
+}
+
+func (w *walker) walkCaseClauses(body *ast.BlockStmt, depth int) {
+	if body == nil {
+		return
+	}
+	for _, stmt := range body.List {
+		cc, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		for _, bodyStmt := range cc.Body {
+			w.walkStmt(bodyStmt, depth+1)
+		}
+	}
+}
+
+func (w *walker) walkCommClauses(body *ast.BlockStmt, depth int) {
+	if body == nil {
+		return
+	}
+	for _, stmt := range body.List {
+		cc, ok := stmt.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+		for _, bodyStmt := range cc.Body {
+			w.walkStmt(bodyStmt, depth+1)
+		}
+	}
+}
+
+// walkIf scores s and recurses into its body (one level deeper) and its
+// else branch (same depth, since an else-if/else is a sibling of the
+// if it replaces, not a nested block).
+func (w *walker) walkIf(s *ast.IfStmt, depth int) {
+	score := 1 + depth
+	message := "deeply nested if statement"
+	if boolScore, _ := boolexpr.Score(w.fset, s.Cond); boolScore > 0 {
+		score += boolScore
+		message = fmt.Sprintf("%s (condition costs +%d for its &&/|| sequence)", message, boolScore)
+	}
+
+	pos := w.fset.Position(s.If)
+	w.findings = append(w.findings, Finding{
+		File:       w.filename,
+		Line:       pos.Line,
+		Col:        pos.Column,
+		Complexity: score,
+		Message:    message,
+	})
+
+	w.walkBlock(s.Body, depth+1)
+
+	switch e := s.Else.(type) {
+	case *ast.IfStmt:
+		w.walkIf(e, depth)
+	case *ast.BlockStmt:
+		w.walkBlock(e, depth)
+	}
+}
@@ -0,0 +1,125 @@
+package nestif
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestAnalyzeFile_NestedIfsFixture(t *testing.T) {
+	fset := token.NewFileSet()
+	path := "../../../tests/fixtures/go/nested_ifs.go"
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+
+	findings := AnalyzeFile(fset, path, file)
+	if len(findings) != 3 {
+		t.Fatalf("got %d findings, want 3", len(findings))
+	}
+
+	wantScores := []int{1, 2, 3}
+	for i, want := range wantScores {
+		if findings[i].Complexity != want {
+			t.Errorf("findings[%d].Complexity = %d, want %d", i, findings[i].Complexity, want)
+		}
+	}
+}
+
+func TestAnalyzeFile(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []int
+	}{
+		{
+			name: "if nested inside a for loop",
+			src: `package p
+func f(xs []int) int {
+	for i := 0; i < len(xs); i++ {
+		if xs[i] < 0 {
+			return xs[i]
+		}
+	}
+	return 0
+}`,
+			want: []int{1},
+		},
+		{
+			name: "ifs nested inside a switch case are not invisible",
+			src: `package p
+func f(x int) int {
+	switch x {
+	case 1:
+		if x > 0 {
+			if x > 1 {
+				return 2
+			}
+		}
+	}
+	return 0
+}`,
+			want: []int{2, 3},
+		},
+		{
+			name: "if nested inside a select case",
+			src: `package p
+func f(a, b chan int) int {
+	select {
+	case v := <-a:
+		if v > 0 {
+			return v
+		}
+	case <-b:
+	}
+	return 0
+}`,
+			want: []int{2},
+		},
+		{
+			name: "else-if siblings stay at the same depth",
+			src: `package p
+func f(x int) int {
+	if x == 1 {
+		return 1
+	} else if x == 2 {
+		return 2
+	}
+	return 0
+}`,
+			want: []int{1, 1},
+		},
+		{
+			name: "boolean sequence break adds to the if's own score",
+			src: `package p
+func f(a, b, c bool) bool {
+	if a && b || c {
+		return true
+	}
+	return false
+}`,
+			want: []int{3}, // 1 (if) + 2 (&&-run, flip to ||)
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "test.go", tt.src, 0)
+			if err != nil {
+				t.Fatalf("ParseFile: %v", err)
+			}
+
+			findings := AnalyzeFile(fset, "test.go", file)
+			if len(findings) != len(tt.want) {
+				t.Fatalf("got %d findings, want %d (findings: %+v)", len(findings), len(tt.want), findings)
+			}
+			for i, want := range tt.want {
+				if findings[i].Complexity != want {
+					t.Errorf("findings[%d].Complexity = %d, want %d", i, findings[i].Complexity, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Package genfixture generates synthetic Go source files with a
+// parameterized amount of nesting, paired with the analytically computed
+// metrics they should produce. Real-world corpora rarely hit the deep
+// nesting tail, so these generated fixtures are what exercise the analyzers'
+// worst-case behaviour and let their throughput be benchmarked.
+package genfixture
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind selects the control-flow construct that Generate nests.
+type Kind string
+
+const (
+	KindIf     Kind = "if"
+	KindFor    Kind = "for"
+	KindSwitch Kind = "switch"
+	KindMixed  Kind = "mixed" // nested ifs with alternating &&/|| conditions
+)
+
+// Options parameterizes a generated fixture.
+type Options struct {
+	Kind  Kind
+	Depth int // number of nested levels, e.g. Depth=3 mirrors tests/fixtures/go/nested_ifs.go
+}
+
+// Expected is the analytically computed result a fixture generated with the
+// same Options should produce, for use as a regression oracle.
+type Expected struct {
+	CognitiveComplexity  int `json:"cognitive_complexity"`
+	MaxNestingDepth      int `json:"max_nesting_depth"`
+	CyclomaticComplexity int `json:"cyclomatic_complexity"`
+}
+
+// Generate returns Go source implementing a single function named
+// "generated" with the requested nesting, plus the metrics that source
+// should score.
+func Generate(opts Options) (source string, expected Expected) {
+	switch opts.Kind {
+	case KindFor:
+		return generateNested("for i := 0; i < n; i++", opts.Depth)
+	case KindSwitch:
+		return generateSwitch(opts.Depth)
+	case KindMixed:
+		return generateMixed(opts.Depth)
+	default:
+		return generateNested("if n > %d", opts.Depth)
+	}
+}
+
+// generateNested builds Depth nested blocks using headerFmt, which may
+// contain at most one %d placeholder for the level index (used by the "if"
+// variant to keep conditions distinct; the "for" variant ignores it).
+func generateNested(headerFmt string, depth int) (string, Expected) {
+	var body strings.Builder
+	indent := "\t"
+	for level := 0; level < depth; level++ {
+		header := headerFmt
+		if strings.Contains(headerFmt, "%d") {
+			header = fmt.Sprintf(headerFmt, level)
+		}
+		fmt.Fprintf(&body, "%s%s {\n", indent, header)
+		indent += "\t"
+	}
+	fmt.Fprintf(&body, "%sreturn true\n", indent)
+	for level := depth - 1; level >= 0; level-- {
+		indent = indent[:len(indent)-1]
+		fmt.Fprintf(&body, "%s}\n", indent)
+	}
+
+	src := fmt.Sprintf("package generated\n\nfunc generated(n int) bool {\n%s\treturn false\n}\n", body.String())
+	return src, Expected{
+		CognitiveComplexity:  depth * (depth + 1) / 2,
+		MaxNestingDepth:      depth,
+		CyclomaticComplexity: depth + 1,
+	}
+}
+
+func generateSwitch(depth int) (string, Expected) {
+	var body strings.Builder
+	indent := "\t"
+	for level := 0; level < depth; level++ {
+		fmt.Fprintf(&body, "%sswitch n {\n%scase %d:\n", indent, indent, level)
+		indent += "\t"
+	}
+	fmt.Fprintf(&body, "%sreturn true\n", indent)
+	for level := depth - 1; level >= 0; level-- {
+		indent = indent[:len(indent)-1]
+		fmt.Fprintf(&body, "%s}\n", indent)
+	}
+
+	src := fmt.Sprintf("package generated\n\nfunc generated(n int) bool {\n%s\treturn false\n}\n", body.String())
+	// Per Campbell's rules the switch statement itself is the scored
+	// structure: it contributes +1 nesting like an if, but the case clauses
+	// inside it are not separately charged.
+	return src, Expected{
+		CognitiveComplexity:  depth * (depth + 1) / 2,
+		MaxNestingDepth:      depth * 2, // each level is a switch wrapping a case, two AST layers deep
+		CyclomaticComplexity: depth + 1,
+	}
+}
+
+// generateMixed nests ifs whose conditions alternate && and || chains. Its
+// Expected reflects the full Campbell scoring, including the boolean
+// sequence-break contribution from package boolexpr.
+func generateMixed(depth int) (string, Expected) {
+	var body strings.Builder
+	indent := "\t"
+	for level := 0; level < depth; level++ {
+		fmt.Fprintf(&body, "%sif a%d && b%d || c%d {\n", indent, level, level, level)
+		indent += "\t"
+	}
+	fmt.Fprintf(&body, "%sreturn true\n", indent)
+	for level := depth - 1; level >= 0; level-- {
+		indent = indent[:len(indent)-1]
+		fmt.Fprintf(&body, "%s}\n", indent)
+	}
+
+	params := make([]string, 0, depth*3)
+	for level := 0; level < depth; level++ {
+		params = append(params,
+			fmt.Sprintf("a%d", level), fmt.Sprintf("b%d", level), fmt.Sprintf("c%d", level))
+	}
+	src := fmt.Sprintf("package generated\n\nfunc generated(%s bool) bool {\n%s\treturn false\n}\n",
+		strings.Join(params, ", "), body.String())
+
+	const boolRunsPerCondition = 2 // one run for the && chain, one more for the flip to ||
+	nestingScore := depth * (depth + 1) / 2
+	booleanScore := depth * boolRunsPerCondition
+	return src, Expected{
+		CognitiveComplexity:  nestingScore + booleanScore,
+		MaxNestingDepth:      depth,
+		CyclomaticComplexity: depth + 1,
+	}
+}
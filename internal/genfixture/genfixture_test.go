@@ -0,0 +1,35 @@
+package genfixture
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/cognitive"
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/maxnesting"
+)
+
+func TestGenerate_MatchesAnalyzers(t *testing.T) {
+	for _, kind := range []Kind{KindIf, KindFor, KindSwitch, KindMixed} {
+		kind := kind
+		t.Run(string(kind), func(t *testing.T) {
+			src, expected := Generate(Options{Kind: kind, Depth: 3})
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "generated.go", src, 0)
+			if err != nil {
+				t.Fatalf("ParseFile: %v\n%s", err, src)
+			}
+
+			scores := cognitive.New(fset).AnalyzeFile(file)
+			if len(scores) != 1 || scores[0].Score != expected.CognitiveComplexity {
+				t.Errorf("cognitive score = %+v, want %d", scores, expected.CognitiveComplexity)
+			}
+
+			depths := maxnesting.AnalyzeFile(fset, file)
+			if len(depths) != 1 || depths[0].MaxDepth != expected.MaxNestingDepth {
+				t.Errorf("max nesting depth = %+v, want %d", depths, expected.MaxNestingDepth)
+			}
+		})
+	}
+}
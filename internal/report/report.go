@@ -0,0 +1,61 @@
+// Package report formats analyzer output for the metricmancer CLI.
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/cognitive"
+	"github.com/CmdrPrompt/MetricMancer/internal/analyzer/maxnesting"
+)
+
+// CognitiveReport renders per-function cognitive complexity scores to w,
+// sorted from most to least complex. all is printed for every function in
+// the file; gated is the subset that should actually be checked against
+// threshold (callers exclude test files and/or benchmarks from gated
+// before calling this, mirroring MaxNestingReport).
+func CognitiveReport(w io.Writer, file string, all, gated []cognitive.FunctionScore, threshold int) (overThreshold bool) {
+	gate := make(map[string]bool, len(gated))
+	for _, fn := range gated {
+		gate[fn.Name] = true
+	}
+
+	sorted := make([]cognitive.FunctionScore, len(all))
+	copy(sorted, all)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Score > sorted[j].Score })
+
+	for _, fn := range sorted {
+		flag := ""
+		if gate[fn.Name] && fn.Score > threshold {
+			flag = " [exceeds threshold]"
+			overThreshold = true
+		}
+		fmt.Fprintf(w, "%s:%d: %s: cognitive complexity %d%s\n", file, fn.Line, fn.Name, fn.Score, flag)
+		for _, inc := range fn.Breakdown {
+			fmt.Fprintf(w, "    %s:%d: %s: +%d (%d base + %d nesting)\n", file, inc.Line, inc.Kind, inc.Total, inc.Base, inc.Nesting)
+		}
+	}
+	return overThreshold
+}
+
+// MaxNestingReport renders per-function max nesting depth to w. all is
+// printed for every function in the file; gated is the subset that should
+// actually be checked against threshold (callers exclude test files and/or
+// benchmarks from gated before calling this).
+func MaxNestingReport(w io.Writer, file string, all, gated []maxnesting.FunctionDepth, threshold int) (overThreshold bool) {
+	gate := make(map[string]bool, len(gated))
+	for _, d := range gated {
+		gate[d.Name] = true
+	}
+
+	for _, d := range all {
+		flag := ""
+		if gate[d.Name] && d.MaxDepth > threshold {
+			flag = " [exceeds threshold]"
+			overThreshold = true
+		}
+		fmt.Fprintf(w, "%s:%d: %s: max nesting depth %d%s\n", file, d.Line, d.Name, d.MaxDepth, flag)
+	}
+	return overThreshold
+}
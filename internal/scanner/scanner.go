@@ -0,0 +1,62 @@
+// Package scanner walks a directory tree collecting Go source files for the
+// other analyzer packages, applying a common set of directory exclusions.
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// GoFiles returns the path of every *.go file found under root, skipping
+// any directory whose name matches one of excludeDirs.
+func GoFiles(root string, excludeDirs []*regexp.Regexp) ([]string, error) {
+	var files []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != root && matchesAny(info.Name(), excludeDirs) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, ".go") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func matchesAny(name string, patterns []*regexp.Regexp) bool {
+	for _, p := range patterns {
+		if p.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileExcludeDirs compiles a comma-separated list of regular expressions,
+// as accepted by the --exclude-dirs flag.
+func CompileExcludeDirs(list string) ([]*regexp.Regexp, error) {
+	if list == "" {
+		return nil, nil
+	}
+	var res []*regexp.Regexp
+	for _, pattern := range strings.Split(list, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}